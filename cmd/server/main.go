@@ -0,0 +1,152 @@
+// Command server runs the polaris-dark-pro user API.
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/config"
+	httpapi "github.com/abxlcrz/polaris-dark-pro/pkg/http"
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "server exited with error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	logger, err := newLogger(cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+	defer logger.Sync()
+
+	repo, err := newRepository(cfg)
+	if err != nil {
+		return err
+	}
+
+	authSvc := users.NewAuthService(repo, users.NewInMemoryRefreshTokenRepository(), users.AuthConfig{
+		Secret:          cfg.JWTSecret,
+		Issuer:          cfg.JWTIssuer,
+		AccessTokenTTL:  cfg.AccessTokenTTL,
+		RefreshTokenTTL: cfg.RefreshTokenTTL,
+		BcryptCost:      cfg.BcryptCost,
+	})
+	userSvc := users.NewUserService(repo, cfg.ReadTimeout)
+
+	seedSampleUsers(logger, userSvc)
+
+	router := httpapi.NewRouter(authSvc, userSvc, repo, logger)
+
+	srv := &http.Server{
+		Addr:         cfg.Port,
+		Handler:      router,
+		ReadTimeout:  cfg.ReadTimeout,
+		WriteTimeout: cfg.WriteTimeout,
+		IdleTimeout:  cfg.IdleTimeout,
+	}
+
+	serverErrors := make(chan error, 1)
+	go func() {
+		logger.Info("server starting", zap.String("port", cfg.Port))
+		serverErrors <- srv.ListenAndServe()
+	}()
+
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
+
+	select {
+	case err := <-serverErrors:
+		if err != nil && err != http.ErrServerClosed {
+			return err
+		}
+		return nil
+
+	case sig := <-shutdown:
+		logger.Info("shutdown started", zap.String("signal", sig.String()))
+		defer logger.Info("shutdown complete", zap.String("signal", sig.String()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+
+		if err := srv.Shutdown(ctx); err != nil {
+			srv.Close()
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newLogger builds a production zap logger at the configured level.
+func newLogger(level string) (*zap.Logger, error) {
+	var zapLevel zapcore.Level
+	if err := zapLevel.UnmarshalText([]byte(level)); err != nil {
+		zapLevel = zapcore.InfoLevel
+	}
+
+	zapCfg := zap.NewProductionConfig()
+	zapCfg.Level = zap.NewAtomicLevelAt(zapLevel)
+	return zapCfg.Build()
+}
+
+// newRepository builds a UserRepository from cfg, layering a read-through
+// cache in front of whichever backend is selected unless disabled.
+func newRepository(cfg config.Config) (users.UserRepository, error) {
+	var repo users.UserRepository
+
+	if cfg.DBDSN == "" {
+		repo = users.NewInMemoryUserRepository()
+	} else {
+		gormRepo, err := users.NewGormUserRepository(users.DBConfig{Driver: cfg.DBDriver, DSN: cfg.DBDSN})
+		if err != nil {
+			return nil, err
+		}
+		repo = gormRepo
+	}
+
+	if cfg.CacheDisabled {
+		return repo, nil
+	}
+
+	return users.NewCachingUserRepository(repo, users.CacheConfig{
+		MaxCost: cfg.CacheMaxCost,
+		TTL:     cfg.CacheTTL,
+	})
+}
+
+// seedSampleUsers adds a few demo accounts so the API has data to serve on
+// a fresh in-memory store.
+func seedSampleUsers(logger *zap.Logger, service *users.UserService) {
+	ctx := context.Background()
+	sampleUsers := []struct{ name, email, role string }{
+		{"Alice Johnson", "alice@example.com", "admin"},
+		{"Bob Smith", "bob@example.com", "user"},
+		{"Carol Brown", "carol@example.com", "guest"},
+	}
+
+	for _, u := range sampleUsers {
+		user, err := service.CreateUser(ctx, u.name, u.email, u.role)
+		if err != nil {
+			logger.Warn("error creating sample user", zap.String("email", u.email), zap.Error(err))
+		} else {
+			logger.Info("sample user created", zap.Uint64("id", user.ID), zap.String("email", user.Email))
+		}
+	}
+}
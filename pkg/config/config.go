@@ -0,0 +1,213 @@
+// Package config loads server configuration from (in increasing order of
+// precedence) built-in defaults, a TOML file, and the environment. The only
+// command-line flag is -config, which names the TOML file; individual
+// settings cannot be given as flags.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Config holds every setting the server needs at boot.
+type Config struct {
+	Port            string
+	ReadTimeout     time.Duration
+	WriteTimeout    time.Duration
+	IdleTimeout     time.Duration
+	ShutdownTimeout time.Duration
+
+	DBDriver string
+	DBDSN    string
+
+	JWTSecret       string
+	JWTIssuer       string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	BcryptCost      int
+
+	CacheDisabled bool
+	CacheMaxCost  int64
+	CacheTTL      time.Duration
+
+	LogLevel string
+}
+
+// fileConfig mirrors Config for the subset of fields a TOML file may set.
+// Durations are plain strings in the file (e.g. "15s") and parsed below.
+type fileConfig struct {
+	Port            string `toml:"port"`
+	ReadTimeout     string `toml:"read_timeout"`
+	WriteTimeout    string `toml:"write_timeout"`
+	IdleTimeout     string `toml:"idle_timeout"`
+	ShutdownTimeout string `toml:"shutdown_timeout"`
+	DBDriver        string `toml:"db_driver"`
+	DBDSN           string `toml:"db_dsn"`
+	JWTSecret       string `toml:"jwt_secret"`
+	JWTIssuer       string `toml:"jwt_issuer"`
+	AccessTokenTTL  string `toml:"access_token_ttl"`
+	RefreshTokenTTL string `toml:"refresh_token_ttl"`
+	BcryptCost      int    `toml:"bcrypt_cost"`
+	CacheDisabled   bool   `toml:"cache_disabled"`
+	CacheMaxCost    int64  `toml:"cache_max_cost"`
+	CacheTTL        string `toml:"cache_ttl"`
+	LogLevel        string `toml:"log_level"`
+}
+
+func defaults() Config {
+	return Config{
+		Port:            ":8080",
+		ReadTimeout:     5 * time.Second,
+		WriteTimeout:    10 * time.Second,
+		IdleTimeout:     2 * time.Minute,
+		ShutdownTimeout: 15 * time.Second,
+		DBDriver:        "postgres",
+		JWTSecret:       "dev-secret-change-me",
+		JWTIssuer:       "polaris-dark-pro",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 7 * 24 * time.Hour,
+		BcryptCost:      10,
+		CacheMaxCost:    1 << 26, // every cached entry costs 1, so ~67M entries
+		CacheTTL:        5 * time.Minute,
+		LogLevel:        "info",
+	}
+}
+
+// Load builds a Config from defaults, an optional -config TOML file, and the
+// environment, in that order of increasing precedence. No flag other than
+// -config is defined.
+func Load() (Config, error) {
+	configPath := flag.String("config", "", "path to a TOML config file")
+	flag.Parse()
+
+	cfg := defaults()
+
+	if *configPath != "" {
+		var fc fileConfig
+		if _, err := toml.DecodeFile(*configPath, &fc); err != nil {
+			return Config{}, fmt.Errorf("load config file %s: %w", *configPath, err)
+		}
+		cfg.applyFile(fc)
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) applyFile(fc fileConfig) {
+	if fc.Port != "" {
+		c.Port = fc.Port
+	}
+	if fc.DBDriver != "" {
+		c.DBDriver = fc.DBDriver
+	}
+	if fc.DBDSN != "" {
+		c.DBDSN = fc.DBDSN
+	}
+	if fc.JWTSecret != "" {
+		c.JWTSecret = fc.JWTSecret
+	}
+	if fc.JWTIssuer != "" {
+		c.JWTIssuer = fc.JWTIssuer
+	}
+	if fc.BcryptCost != 0 {
+		c.BcryptCost = fc.BcryptCost
+	}
+	if fc.CacheMaxCost != 0 {
+		c.CacheMaxCost = fc.CacheMaxCost
+	}
+	c.CacheDisabled = fc.CacheDisabled
+	if fc.LogLevel != "" {
+		c.LogLevel = fc.LogLevel
+	}
+
+	for _, d := range []struct {
+		src string
+		dst *time.Duration
+	}{
+		{fc.ReadTimeout, &c.ReadTimeout},
+		{fc.WriteTimeout, &c.WriteTimeout},
+		{fc.IdleTimeout, &c.IdleTimeout},
+		{fc.ShutdownTimeout, &c.ShutdownTimeout},
+		{fc.AccessTokenTTL, &c.AccessTokenTTL},
+		{fc.RefreshTokenTTL, &c.RefreshTokenTTL},
+		{fc.CacheTTL, &c.CacheTTL},
+	} {
+		if d.src == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(d.src)
+		if err == nil {
+			*d.dst = parsed
+		}
+	}
+}
+
+func (c *Config) applyEnv() error {
+	c.Port = envOrDefault("SERVER_PORT", c.Port)
+	c.DBDriver = envOrDefault("DB_DRIVER", c.DBDriver)
+	c.DBDSN = envOrDefault("DB_DSN", c.DBDSN)
+	c.JWTSecret = envOrDefault("AUTH_JWT_SECRET", c.JWTSecret)
+	c.JWTIssuer = envOrDefault("AUTH_JWT_ISSUER", c.JWTIssuer)
+	c.LogLevel = envOrDefault("LOG_LEVEL", c.LogLevel)
+
+	if v := os.Getenv("AUTH_BCRYPT_COST"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse AUTH_BCRYPT_COST: %w", err)
+		}
+		c.BcryptCost = n
+	}
+
+	if v := os.Getenv("CACHE_DISABLED"); v != "" {
+		c.CacheDisabled = v == "true"
+	}
+
+	if v := os.Getenv("CACHE_MAX_COST"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse CACHE_MAX_COST: %w", err)
+		}
+		c.CacheMaxCost = n
+	}
+
+	for _, d := range []struct {
+		key string
+		dst *time.Duration
+	}{
+		{"SERVER_READ_TIMEOUT", &c.ReadTimeout},
+		{"SERVER_WRITE_TIMEOUT", &c.WriteTimeout},
+		{"SERVER_IDLE_TIMEOUT", &c.IdleTimeout},
+		{"SERVER_SHUTDOWN_TIMEOUT", &c.ShutdownTimeout},
+		{"AUTH_ACCESS_TTL", &c.AccessTokenTTL},
+		{"AUTH_REFRESH_TTL", &c.RefreshTokenTTL},
+		{"CACHE_TTL", &c.CacheTTL},
+	} {
+		v := os.Getenv(d.key)
+		if v == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse %s: %w", d.key, err)
+		}
+		*d.dst = parsed
+	}
+
+	return nil
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,113 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+// AuthHandler exposes the /auth/* endpoints.
+type AuthHandler struct {
+	auth    *users.AuthService
+	service *users.UserService
+}
+
+// NewAuthHandler creates an AuthHandler.
+func NewAuthHandler(auth *users.AuthService, service *users.UserService) *AuthHandler {
+	return &AuthHandler{auth: auth, service: service}
+}
+
+// RegisterHandler handles POST /auth/register. It never accepts a
+// caller-supplied role — see users.DefaultRegistrationRole — so privileged
+// roles can only be granted by an admin via POST /users.
+func (h *AuthHandler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.auth.Register(r.Context(), req.Name, req.Email, req.Password)
+	if err != nil {
+		writeUserError(w, err)
+		return
+	}
+	usersCreatedTotal.Inc()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(user)
+}
+
+// LoginHandler handles POST /auth/login
+func (h *AuthHandler) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	access, refresh, err := h.auth.Login(r.Context(), req.Email, req.Password)
+	if err != nil {
+		http.Error(w, "invalid email or password", http.StatusUnauthorized)
+		return
+	}
+
+	writeTokenPair(w, access, refresh)
+}
+
+// RefreshHandler handles POST /auth/refresh
+func (h *AuthHandler) RefreshHandler(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	access, refresh, err := h.auth.Refresh(r.Context(), req.RefreshToken)
+	if err != nil {
+		http.Error(w, "invalid or expired refresh token", http.StatusUnauthorized)
+		return
+	}
+
+	writeTokenPair(w, access, refresh)
+}
+
+// MeHandler handles GET /auth/me, returning the authenticated user.
+func (h *AuthHandler) MeHandler(w http.ResponseWriter, r *http.Request) {
+	claims, ok := userFromContext(r.Context())
+	if !ok {
+		http.Error(w, "not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	user, err := h.service.GetUser(r.Context(), claims.UserID)
+	if err != nil {
+		writeUserError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+func writeTokenPair(w http.ResponseWriter, access, refresh string) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		AccessToken  string `json:"access_token"`
+		RefreshToken string `json:"refresh_token"`
+	}{AccessToken: access, RefreshToken: refresh})
+}
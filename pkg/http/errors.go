@@ -0,0 +1,24 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+// writeUserError maps a typed repository/service error to the right HTTP
+// status instead of always returning 500.
+func writeUserError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, users.ErrNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+	case errors.Is(err, users.ErrConflict):
+		http.Error(w, err.Error(), http.StatusConflict)
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		http.Error(w, err.Error(), http.StatusGatewayTimeout)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+// healthzHandler is the liveness probe: if the process can answer, it's live.
+func healthzHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ok"))
+}
+
+// readyzHandler is the readiness probe: it additionally pings the
+// repository, so a database outage takes the instance out of rotation.
+func readyzHandler(repo users.UserRepository) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pinger, ok := repo.(users.Pinger)
+		if !ok {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte("ready"))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+		defer cancel()
+
+		if err := pinger.Ping(ctx); err != nil {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("ready"))
+	}
+}
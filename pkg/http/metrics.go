@@ -0,0 +1,61 @@
+package http
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duration of HTTP requests in seconds, by route, method, and status.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total HTTP requests handled, by route, method, and status.",
+	}, []string{"route", "method", "status"})
+
+	usersCreatedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "users_created_total",
+		Help: "Total users created via registration or the admin create endpoint.",
+	})
+)
+
+// cacheMetricsSource is satisfied by users.CachingUserRepository. It's
+// declared locally so registerCacheMetrics can accept any UserRepository
+// and only wire up the gauges when caching is actually in front of it.
+type cacheMetricsSource interface {
+	HitCount() uint64
+	MissCount() uint64
+}
+
+// registerCacheMetrics exposes a repository's cache hit/miss counters as
+// gauges on /metrics, if repo is cache-backed. Registration errors from a
+// repeat call (e.g. router re-creation in tests) are ignored.
+func registerCacheMetrics(repo interface{}) {
+	cache, ok := repo.(cacheMetricsSource)
+	if !ok {
+		return
+	}
+
+	hits := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "user_cache_hits_total",
+		Help: "Total read-through cache hits for user lookups.",
+	}, func() float64 { return float64(cache.HitCount()) })
+
+	misses := prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "user_cache_misses_total",
+		Help: "Total read-through cache misses for user lookups.",
+	}, func() float64 { return float64(cache.MissCount()) })
+
+	for _, collector := range []prometheus.Collector{hits, misses} {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if err := prometheus.Register(collector); err != nil && !errors.As(err, &alreadyRegistered) {
+			panic(err)
+		}
+	}
+}
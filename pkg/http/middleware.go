@@ -0,0 +1,149 @@
+// Package http contains the HTTP transport layer: route wiring, request
+// handlers, and middleware on top of pkg/users.
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+// contextKey namespaces values this package stores in context.Context.
+type contextKey string
+
+const (
+	authUserContextKey  contextKey = "auth_user"
+	requestIDContextKey contextKey = "request_id"
+)
+
+// RequestIDHeader is the response header carrying the request ID assigned
+// by the RequestID middleware.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID assigns a UUID to every request, exposing it on the response
+// header and in the request context for downstream handlers and logging.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := uuid.NewString()
+		w.Header().Set(RequestIDHeader, id)
+		ctx := context.WithValue(r.Context(), requestIDContextKey, id)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func requestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey).(string)
+	return id
+}
+
+// statusRecorder captures the status code written by a handler, and the
+// claims set by Auth further down the chain, so they can be reported to the
+// logger and metrics after the handler returns.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	claims *users.AccessClaims
+}
+
+func (rec *statusRecorder) WriteHeader(status int) {
+	rec.status = status
+	rec.ResponseWriter.WriteHeader(status)
+}
+
+// Observe logs each request at info level (method, path, status, duration,
+// request ID, and user ID when authenticated) and records it in the
+// http_request_duration_seconds histogram and http_requests_total counter.
+func Observe(logger *zap.Logger) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			duration := time.Since(start)
+			route := routeTemplate(r)
+			status := strconv.Itoa(rec.status)
+
+			httpRequestDuration.WithLabelValues(route, r.Method, status).Observe(duration.Seconds())
+			httpRequestsTotal.WithLabelValues(route, r.Method, status).Inc()
+
+			fields := []zap.Field{
+				zap.String("method", r.Method),
+				zap.String("path", r.URL.Path),
+				zap.Int("status", rec.status),
+				zap.Duration("duration", duration),
+				zap.String("request_id", requestIDFromContext(r.Context())),
+			}
+			if rec.claims != nil {
+				fields = append(fields, zap.Uint64("user_id", rec.claims.UserID))
+			}
+			logger.Info("http request", fields...)
+		})
+	}
+}
+
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tmpl, err := route.GetPathTemplate(); err == nil {
+			return tmpl
+		}
+	}
+	return r.URL.Path
+}
+
+// Auth returns middleware that authenticates requests via a bearer JWT and,
+// when roles are given, rejects any authenticated user whose role is not in
+// the allow-list. The authenticated claims are injected into the request
+// context for downstream handlers.
+func Auth(authSvc *users.AuthService, roles ...string) mux.MiddlewareFunc {
+	allowed := make(map[string]struct{}, len(roles))
+	for _, role := range roles {
+		allowed[role] = struct{}{}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header := r.Header.Get("Authorization")
+			tokenString, ok := strings.CutPrefix(header, "Bearer ")
+			if !ok || tokenString == "" {
+				http.Error(w, "missing bearer token", http.StatusUnauthorized)
+				return
+			}
+
+			claims, err := authSvc.ParseAccessToken(tokenString)
+			if err != nil {
+				http.Error(w, "invalid or expired token", http.StatusUnauthorized)
+				return
+			}
+
+			if len(allowed) > 0 {
+				if _, ok := allowed[claims.Role]; !ok {
+					http.Error(w, "insufficient role", http.StatusForbidden)
+					return
+				}
+			}
+
+			if rec, ok := w.(*statusRecorder); ok {
+				rec.claims = claims
+			}
+
+			ctx := context.WithValue(r.Context(), authUserContextKey, claims)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// userFromContext extracts the authenticated claims injected by Auth.
+func userFromContext(ctx context.Context) (*users.AccessClaims, bool) {
+	claims, ok := ctx.Value(authUserContextKey).(*users.AccessClaims)
+	return claims, ok
+}
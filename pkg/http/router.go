@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+// NewRouter wires the /auth, /users, and operational routes onto a fresh
+// mux.Router, chaining request-ID and observability middleware on every
+// request that matches a route (mux runs no middleware for unmatched ones).
+func NewRouter(authSvc *users.AuthService, userSvc *users.UserService, repo users.UserRepository, logger *zap.Logger) *mux.Router {
+	authHandler := NewAuthHandler(authSvc, userSvc)
+	userHandler := NewUserHandler(userSvc)
+	registerCacheMetrics(repo)
+
+	r := mux.NewRouter()
+	r.Use(RequestID)
+	r.Use(Observe(logger))
+
+	r.HandleFunc("/auth/register", authHandler.RegisterHandler).Methods("POST")
+	r.HandleFunc("/auth/login", authHandler.LoginHandler).Methods("POST")
+	r.HandleFunc("/auth/refresh", authHandler.RefreshHandler).Methods("POST")
+
+	me := r.NewRoute().Subrouter()
+	me.Use(Auth(authSvc))
+	me.HandleFunc("/auth/me", authHandler.MeHandler).Methods("GET")
+	me.HandleFunc("/users/{id:[0-9]+}", userHandler.GetUserHandler).Methods("GET")
+
+	admin := r.NewRoute().Subrouter()
+	admin.Use(Auth(authSvc, "admin"))
+	admin.HandleFunc("/users", userHandler.CreateUserHandler).Methods("POST")
+	admin.HandleFunc("/users", userHandler.ListUsersHandler).Methods("GET")
+
+	r.HandleFunc("/healthz", healthzHandler).Methods("GET")
+	r.HandleFunc("/readyz", readyzHandler(repo)).Methods("GET")
+	r.Handle("/metrics", promhttp.Handler()).Methods("GET")
+
+	return r
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/abxlcrz/polaris-dark-pro/pkg/users"
+)
+
+// UserHandler handles HTTP requests for users
+type UserHandler struct {
+	service *users.UserService
+}
+
+// NewUserHandler creates a new user handler
+func NewUserHandler(service *users.UserService) *UserHandler {
+	return &UserHandler{service: service}
+}
+
+// CreateUserHandler handles POST /users
+func (h *UserHandler) CreateUserHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.service.Timeout())
+	defer cancel()
+
+	var req struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+		Role  string `json:"role"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.service.CreateUser(ctx, req.Name, req.Email, req.Role)
+	if err != nil {
+		writeUserError(w, err)
+		return
+	}
+	usersCreatedTotal.Inc()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(user)
+}
+
+// GetUserHandler handles GET /users/{id}
+func (h *UserHandler) GetUserHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.service.Timeout())
+	defer cancel()
+
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	user, err := h.service.GetUser(ctx, id)
+	if err != nil {
+		writeUserError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(user)
+}
+
+// ListUsersHandler handles GET /users
+func (h *UserHandler) ListUsersHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.service.Timeout())
+	defer cancel()
+
+	params, err := parseListUsersParams(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	list, metadata, err := h.service.ListUsers(ctx, params)
+	if err != nil {
+		writeUserError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(struct {
+		Metadata users.Metadata `json:"metadata"`
+		Users    []*users.User  `json:"users"`
+	}{Metadata: metadata, Users: list})
+}
+
+// parseListUsersParams reads page, page_size, sort, and filter query
+// params off the request.
+func parseListUsersParams(r *http.Request) (users.ListUsersParams, error) {
+	q := r.URL.Query()
+
+	params := users.ListUsersParams{
+		Page:     1,
+		PageSize: 20,
+		Filters:  make(map[string]string),
+	}
+
+	if v := q.Get("page"); v != "" {
+		page, err := strconv.Atoi(v)
+		if err != nil || page < 1 {
+			return params, fmt.Errorf("invalid page %q", v)
+		}
+		params.Page = page
+	}
+
+	if v := q.Get("page_size"); v != "" {
+		pageSize, err := strconv.Atoi(v)
+		if err != nil || pageSize < 1 {
+			return params, fmt.Errorf("invalid page_size %q", v)
+		}
+		params.PageSize = pageSize
+	}
+
+	if v := q.Get("sort"); v != "" {
+		params.Sort = strings.Split(v, ",")
+	}
+
+	for _, key := range []string{"name", "email", "role"} {
+		if v := q.Get(key); v != "" {
+			params.Filters[key] = v
+		}
+	}
+
+	return params, nil
+}
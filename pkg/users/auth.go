@@ -0,0 +1,204 @@
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// AuthConfig holds the settings needed to issue and verify tokens.
+type AuthConfig struct {
+	Secret          string
+	Issuer          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	BcryptCost      int
+}
+
+// AccessClaims are the custom JWT claims carried by access tokens.
+type AccessClaims struct {
+	UserID uint64 `json:"uid"`
+	Role   string `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// RefreshTokenRepository stores issued refresh tokens so they can be rotated
+// and revoked. The in-memory implementation is sufficient for tests; a
+// database-backed one would follow the same pattern as UserRepository.
+type RefreshTokenRepository interface {
+	Store(ctx context.Context, token string, userID uint64, expiresAt time.Time) error
+	Consume(ctx context.Context, token string) (userID uint64, err error)
+}
+
+// InMemoryRefreshTokenRepository is a process-local RefreshTokenRepository.
+// mu guards tokens since net/http serves handlers concurrently.
+type InMemoryRefreshTokenRepository struct {
+	mu     sync.Mutex
+	tokens map[string]refreshTokenRecord
+}
+
+type refreshTokenRecord struct {
+	userID    uint64
+	expiresAt time.Time
+}
+
+// NewInMemoryRefreshTokenRepository creates an empty refresh token store.
+func NewInMemoryRefreshTokenRepository() *InMemoryRefreshTokenRepository {
+	return &InMemoryRefreshTokenRepository{tokens: make(map[string]refreshTokenRecord)}
+}
+
+// Store records a freshly issued refresh token.
+func (r *InMemoryRefreshTokenRepository) Store(ctx context.Context, token string, userID uint64, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[token] = refreshTokenRecord{userID: userID, expiresAt: expiresAt}
+	return nil
+}
+
+// Consume validates and revokes a refresh token in one step, so a token can
+// only ever be redeemed once (rotation).
+func (r *InMemoryRefreshTokenRepository) Consume(ctx context.Context, token string) (uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, exists := r.tokens[token]
+	if !exists {
+		return 0, ErrNotFound
+	}
+	delete(r.tokens, token)
+
+	if time.Now().After(record.expiresAt) {
+		return 0, ErrNotFound
+	}
+	return record.userID, nil
+}
+
+// AuthService issues and validates access/refresh tokens on top of a
+// UserRepository.
+type AuthService struct {
+	repo    UserRepository
+	refresh RefreshTokenRepository
+	cfg     AuthConfig
+}
+
+// NewAuthService creates an AuthService.
+func NewAuthService(repo UserRepository, refresh RefreshTokenRepository, cfg AuthConfig) *AuthService {
+	return &AuthService{repo: repo, refresh: refresh, cfg: cfg}
+}
+
+// DefaultRegistrationRole is the role assigned to every self-registered
+// account. Self-registration never accepts a caller-supplied role — that
+// would let anyone hand themselves "admin" and pass the RBAC checks in
+// pkg/http's Auth middleware. Granting any other role requires an
+// already-authenticated admin, via the admin-only POST /users endpoint.
+const DefaultRegistrationRole = "user"
+
+// Register creates a new user with a bcrypt-hashed password. The new
+// account is always created with DefaultRegistrationRole.
+func (a *AuthService) Register(ctx context.Context, name, email, password string) (*User, error) {
+	if name == "" || email == "" || password == "" {
+		return nil, fmt.Errorf("name, email, and password are required")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), a.cfg.BcryptCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+
+	user := &User{Name: name, Email: email, Role: DefaultRegistrationRole, PasswordHash: string(hash)}
+	if err := a.repo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// Login verifies credentials and issues a fresh access/refresh token pair.
+func (a *AuthService) Login(ctx context.Context, email, password string) (accessToken, refreshToken string, err error) {
+	user, err := a.repo.FindByEmail(ctx, email)
+	if err != nil {
+		return "", "", ErrNotFound
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", "", ErrNotFound
+	}
+
+	return a.issueTokenPair(ctx, user)
+}
+
+// Refresh rotates a refresh token: the presented token is consumed and a new
+// access/refresh pair is issued in its place.
+func (a *AuthService) Refresh(ctx context.Context, refreshToken string) (accessToken, newRefreshToken string, err error) {
+	userID, err := a.refresh.Consume(ctx, refreshToken)
+	if err != nil {
+		return "", "", ErrNotFound
+	}
+
+	user, err := a.repo.Get(ctx, userID)
+	if err != nil {
+		return "", "", ErrNotFound
+	}
+
+	return a.issueTokenPair(ctx, user)
+}
+
+func (a *AuthService) issueTokenPair(ctx context.Context, user *User) (accessToken, refreshToken string, err error) {
+	now := time.Now()
+	claims := AccessClaims{
+		UserID: user.ID,
+		Role:   user.Role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    a.cfg.Issuer,
+			Subject:   strconv.FormatUint(user.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.cfg.AccessTokenTTL)),
+		},
+	}
+
+	access, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(a.cfg.Secret))
+	if err != nil {
+		return "", "", fmt.Errorf("sign access token: %w", err)
+	}
+
+	refresh, err := newRandomToken()
+	if err != nil {
+		return "", "", fmt.Errorf("generate refresh token: %w", err)
+	}
+
+	if err := a.refresh.Store(ctx, refresh, user.ID, now.Add(a.cfg.RefreshTokenTTL)); err != nil {
+		return "", "", err
+	}
+
+	return access, refresh, nil
+}
+
+// ParseAccessToken validates signature and expiry and returns the claims.
+func (a *AuthService) ParseAccessToken(tokenString string) (*AccessClaims, error) {
+	claims := &AccessClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return []byte(a.cfg.Secret), nil
+	}, jwt.WithIssuer(a.cfg.Issuer))
+	if err != nil || !token.Valid {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	return claims, nil
+}
+
+func newRandomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
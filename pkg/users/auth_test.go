@@ -0,0 +1,90 @@
+package users
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func newTestAuthService() *AuthService {
+	repo := NewInMemoryUserRepository()
+	refresh := NewInMemoryRefreshTokenRepository()
+	cfg := AuthConfig{
+		Secret:          "test-secret",
+		Issuer:          "polaris-dark-pro-test",
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+		BcryptCost:      4, // lowest valid bcrypt cost, keeps tests fast
+	}
+	return NewAuthService(repo, refresh, cfg)
+}
+
+func TestAuthServiceRegisterAndLogin(t *testing.T) {
+	ctx := context.Background()
+	auth := newTestAuthService()
+
+	user, err := auth.Register(ctx, "Alice", "alice@example.com", "correct-horse")
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if user.PasswordHash == "" || user.PasswordHash == "correct-horse" {
+		t.Fatalf("password was not hashed: %q", user.PasswordHash)
+	}
+
+	access, refresh, err := auth.Login(ctx, "alice@example.com", "correct-horse")
+	if err != nil {
+		t.Fatalf("Login with correct password: %v", err)
+	}
+	if access == "" || refresh == "" {
+		t.Fatalf("Login returned empty tokens")
+	}
+
+	claims, err := auth.ParseAccessToken(access)
+	if err != nil {
+		t.Fatalf("ParseAccessToken: %v", err)
+	}
+	if claims.UserID != user.ID || claims.Role != DefaultRegistrationRole {
+		t.Fatalf("got claims %+v, want UserID=%d Role=%s", claims, user.ID, DefaultRegistrationRole)
+	}
+
+	if _, _, err := auth.Login(ctx, "alice@example.com", "wrong-password"); err != ErrNotFound {
+		t.Fatalf("Login with wrong password: got err=%v, want ErrNotFound", err)
+	}
+
+	if _, _, err := auth.Login(ctx, "nobody@example.com", "whatever"); err != ErrNotFound {
+		t.Fatalf("Login with unknown email: got err=%v, want ErrNotFound", err)
+	}
+}
+
+func TestAuthServiceRefreshRotation(t *testing.T) {
+	ctx := context.Background()
+	auth := newTestAuthService()
+
+	if _, err := auth.Register(ctx, "Bob", "bob@example.com", "hunter2"); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	_, refresh1, err := auth.Login(ctx, "bob@example.com", "hunter2")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+
+	access2, refresh2, err := auth.Refresh(ctx, refresh1)
+	if err != nil {
+		t.Fatalf("Refresh with valid token: %v", err)
+	}
+	if access2 == "" || refresh2 == "" {
+		t.Fatalf("Refresh returned empty tokens")
+	}
+	if refresh2 == refresh1 {
+		t.Fatalf("Refresh did not rotate the refresh token")
+	}
+
+	if _, _, err := auth.Refresh(ctx, refresh1); err != ErrNotFound {
+		t.Fatalf("reusing a consumed refresh token: got err=%v, want ErrNotFound", err)
+	}
+
+	if _, _, err := auth.Refresh(ctx, "not-a-real-token"); err != ErrNotFound {
+		t.Fatalf("Refresh with unknown token: got err=%v, want ErrNotFound", err)
+	}
+}
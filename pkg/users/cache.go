@@ -0,0 +1,128 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dgraph-io/ristretto"
+)
+
+// CacheConfig controls the read-through cache layered in front of a
+// UserRepository.
+type CacheConfig struct {
+	MaxCost int64
+	TTL     time.Duration
+}
+
+// CachingUserRepository wraps a UserRepository with a Ristretto read-through
+// cache for Get and FindByEmail. Create populates the cache, Update/Delete
+// invalidate it, so it never serves stale data.
+type CachingUserRepository struct {
+	next  UserRepository
+	cache *ristretto.Cache
+	ttl   time.Duration
+}
+
+// NewCachingUserRepository wraps next with a Ristretto cache sized per cfg.
+func NewCachingUserRepository(next UserRepository, cfg CacheConfig) (*CachingUserRepository, error) {
+	cache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: cfg.MaxCost * 10,
+		MaxCost:     cfg.MaxCost,
+		BufferItems: 64,
+		Metrics:     true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create ristretto cache: %w", err)
+	}
+
+	return &CachingUserRepository{next: next, cache: cache, ttl: cfg.TTL}, nil
+}
+
+func userCacheKey(id uint64) string     { return "user:id:" + strconv.FormatUint(id, 10) }
+func emailCacheKey(email string) string { return "user:email:" + email }
+
+// Create delegates to next and primes the cache with the new user.
+func (c *CachingUserRepository) Create(ctx context.Context, user *User) error {
+	if err := c.next.Create(ctx, user); err != nil {
+		return err
+	}
+	c.cache.SetWithTTL(userCacheKey(user.ID), user, 1, c.ttl)
+	c.cache.SetWithTTL(emailCacheKey(user.Email), user, 1, c.ttl)
+	return nil
+}
+
+// Get serves from cache on a hit, otherwise falls through to next and
+// populates the cache.
+func (c *CachingUserRepository) Get(ctx context.Context, id uint64) (*User, error) {
+	if v, ok := c.cache.Get(userCacheKey(id)); ok {
+		return v.(*User), nil
+	}
+
+	user, err := c.next.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.SetWithTTL(userCacheKey(id), user, 1, c.ttl)
+	return user, nil
+}
+
+// List is not cached; pagination/filter combinations make the key space
+// unbounded, so it passes straight through to next.
+func (c *CachingUserRepository) List(ctx context.Context, params ListUsersParams) ([]*User, Metadata, error) {
+	return c.next.List(ctx, params)
+}
+
+// Update delegates to next and invalidates the cached entries for user. The
+// existing record is fetched first so that, if the update changes the
+// user's email, the stale entry under the old email key is invalidated too
+// rather than left to serve outdated data until it expires.
+func (c *CachingUserRepository) Update(ctx context.Context, user *User) error {
+	existing, _ := c.next.Get(ctx, user.ID)
+	if err := c.next.Update(ctx, user); err != nil {
+		return err
+	}
+	c.cache.Del(userCacheKey(user.ID))
+	if existing != nil {
+		c.cache.Del(emailCacheKey(existing.Email))
+	}
+	c.cache.Del(emailCacheKey(user.Email))
+	return nil
+}
+
+// Delete delegates to next and invalidates the cached entries for id.
+func (c *CachingUserRepository) Delete(ctx context.Context, id uint64) error {
+	existing, _ := c.next.Get(ctx, id)
+	if err := c.next.Delete(ctx, id); err != nil {
+		return err
+	}
+	c.cache.Del(userCacheKey(id))
+	if existing != nil {
+		c.cache.Del(emailCacheKey(existing.Email))
+	}
+	return nil
+}
+
+// FindByEmail serves from cache on a hit, otherwise falls through to next
+// and populates the cache.
+func (c *CachingUserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
+	if v, ok := c.cache.Get(emailCacheKey(email)); ok {
+		return v.(*User), nil
+	}
+
+	user, err := c.next.FindByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.SetWithTTL(emailCacheKey(email), user, 1, c.ttl)
+	return user, nil
+}
+
+// HitCount returns the number of cache hits so far. Exposed for the metrics
+// endpoint.
+func (c *CachingUserRepository) HitCount() uint64 { return c.cache.Metrics.Hits() }
+
+// MissCount returns the number of cache misses so far. Exposed for the
+// metrics endpoint.
+func (c *CachingUserRepository) MissCount() uint64 { return c.cache.Metrics.Misses() }
@@ -0,0 +1,161 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// DBConfig describes how to connect to the relational backend.
+type DBConfig struct {
+	Driver string // "postgres" or "mysql"
+	DSN    string
+}
+
+// GormUserRepository is a UserRepository backed by GORM, supporting Postgres
+// and MySQL. CreatedAt/UpdatedAt are managed by the ORM.
+type GormUserRepository struct {
+	db *gorm.DB
+}
+
+// NewGormUserRepository opens a connection per cfg.Driver and runs
+// migrations before returning.
+func NewGormUserRepository(cfg DBConfig) (*GormUserRepository, error) {
+	var dialector gorm.Dialector
+	switch cfg.Driver {
+	case "postgres":
+		dialector = postgres.Open(cfg.DSN)
+	case "mysql":
+		dialector = mysql.Open(cfg.DSN)
+	default:
+		return nil, fmt.Errorf("unsupported db driver %q", cfg.Driver)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{TranslateError: true})
+	if err != nil {
+		return nil, fmt.Errorf("connect to database: %w", err)
+	}
+
+	if err := db.AutoMigrate(&User{}); err != nil {
+		return nil, fmt.Errorf("run migrations: %w", err)
+	}
+
+	return &GormUserRepository{db: db}, nil
+}
+
+// Create stores a new user, letting GORM set ID and timestamps.
+func (r *GormUserRepository) Create(ctx context.Context, user *User) error {
+	if err := r.db.WithContext(ctx).Create(user).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrConflict
+		}
+		return err
+	}
+	return nil
+}
+
+// Get returns the user with the given ID, or ErrNotFound.
+func (r *GormUserRepository) Get(ctx context.Context, id uint64) (*User, error) {
+	var user User
+	if err := r.db.WithContext(ctx).First(&user, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+// List returns a filtered, sorted page of users. The total record count
+// comes from a separate COUNT query over the same filters; it is not a
+// window function on the page query.
+func (r *GormUserRepository) List(ctx context.Context, params ListUsersParams) ([]*User, Metadata, error) {
+	params.Normalize()
+
+	// LOWER(...) LIKE LOWER(?) is used instead of ILIKE so filtering works on
+	// both Postgres and MySQL (ILIKE is Postgres-only).
+	query := r.db.WithContext(ctx).Model(&User{})
+	if name := params.Filters["name"]; name != "" {
+		query = query.Where("LOWER(name) LIKE LOWER(?)", "%"+name+"%")
+	}
+	if email := params.Filters["email"]; email != "" {
+		query = query.Where("LOWER(email) LIKE LOWER(?)", "%"+email+"%")
+	}
+	if role := params.Filters["role"]; role != "" {
+		query = query.Where("role = ?", role)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, Metadata{}, err
+	}
+
+	for _, field := range params.Sort {
+		desc := strings.HasPrefix(field, "-")
+		field = strings.TrimPrefix(field, "-")
+		if !allowedSortFields[field] {
+			return nil, Metadata{}, fmt.Errorf("invalid sort field %q", field)
+		}
+		if desc {
+			query = query.Order(field + " DESC")
+		} else {
+			query = query.Order(field + " ASC")
+		}
+	}
+
+	var users []*User
+	offset := (params.Page - 1) * params.PageSize
+	if err := query.Limit(params.PageSize).Offset(offset).Find(&users).Error; err != nil {
+		return nil, Metadata{}, err
+	}
+
+	return users, calculateMetadata(int(total), params.Page, params.PageSize), nil
+}
+
+// Update overwrites an existing user, or returns ErrNotFound. Save is used
+// instead of Updates so zeroed fields (e.g. a cleared Role) are persisted
+// too, matching the interface's full-replace semantics. The user is looked
+// up first because Save inserts a new row when no existing row matches.
+func (r *GormUserRepository) Update(ctx context.Context, user *User) error {
+	if _, err := r.Get(ctx, user.ID); err != nil {
+		return err
+	}
+
+	result := r.db.WithContext(ctx).Save(user)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a user, or returns ErrNotFound.
+func (r *GormUserRepository) Delete(ctx context.Context, id uint64) error {
+	result := r.db.WithContext(ctx).Delete(&User{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// FindByEmail returns the user with the given email, or ErrNotFound.
+func (r *GormUserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
+	var user User
+	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,31 @@
+package users
+
+import "context"
+
+// Pinger is implemented by repositories that can check connectivity to
+// their backing store. Used by the /readyz readiness probe.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// Ping always succeeds: the in-memory store has no external dependency.
+func (r *InMemoryUserRepository) Ping(ctx context.Context) error {
+	return nil
+}
+
+// Ping delegates to next if it supports pinging, otherwise succeeds.
+func (c *CachingUserRepository) Ping(ctx context.Context) error {
+	if pinger, ok := c.next.(Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+// Ping checks connectivity to the underlying database.
+func (r *GormUserRepository) Ping(ctx context.Context) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,204 @@
+package users
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UserRepository is the persistence boundary for User records. Swapping the
+// in-memory store for a database-backed one only requires a new
+// implementation of this interface.
+type UserRepository interface {
+	Create(ctx context.Context, user *User) error
+	Get(ctx context.Context, id uint64) (*User, error)
+	List(ctx context.Context, params ListUsersParams) ([]*User, Metadata, error)
+	Update(ctx context.Context, user *User) error
+	Delete(ctx context.Context, id uint64) error
+	FindByEmail(ctx context.Context, email string) (*User, error)
+}
+
+// InMemoryUserRepository is a process-local UserRepository backed by a map.
+// It has no external dependencies, which makes it the default for tests. All
+// access is guarded by mu since net/http serves handlers concurrently.
+type InMemoryUserRepository struct {
+	mu     sync.RWMutex
+	users  map[uint64]*User
+	nextID uint64
+}
+
+// NewInMemoryUserRepository creates an empty in-memory repository.
+func NewInMemoryUserRepository() *InMemoryUserRepository {
+	return &InMemoryUserRepository{
+		users:  make(map[uint64]*User),
+		nextID: 1,
+	}
+}
+
+// Create stores a new user, assigning it an ID and timestamps.
+func (r *InMemoryUserRepository) Create(ctx context.Context, user *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if existing.Email == user.Email {
+			return ErrConflict
+		}
+	}
+
+	now := time.Now()
+	user.ID = r.nextID
+	user.CreatedAt = now
+	user.UpdatedAt = now
+
+	r.users[user.ID] = user
+	r.nextID++
+	return nil
+}
+
+// Get returns the user with the given ID, or ErrNotFound.
+func (r *InMemoryUserRepository) Get(ctx context.Context, id uint64) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	user, exists := r.users[id]
+	if !exists {
+		return nil, ErrNotFound
+	}
+	return user, nil
+}
+
+// List returns a filtered, sorted page of users.
+func (r *InMemoryUserRepository) List(ctx context.Context, params ListUsersParams) ([]*User, Metadata, error) {
+	params.Normalize()
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*User, 0, len(r.users))
+	for _, user := range r.users {
+		if matchesFilters(user, params.Filters) {
+			matched = append(matched, user)
+		}
+	}
+
+	sortUsers(matched, params.Sort)
+
+	total := len(matched)
+	start := (params.Page - 1) * params.PageSize
+	if start > total {
+		start = total
+	}
+	end := start + params.PageSize
+	if end > total {
+		end = total
+	}
+
+	return matched[start:end], calculateMetadata(total, params.Page, params.PageSize), nil
+}
+
+// Update overwrites an existing user, or returns ErrNotFound.
+func (r *InMemoryUserRepository) Update(ctx context.Context, user *User) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, exists := r.users[user.ID]
+	if !exists {
+		return ErrNotFound
+	}
+
+	user.CreatedAt = existing.CreatedAt
+	user.UpdatedAt = time.Now()
+	r.users[user.ID] = user
+	return nil
+}
+
+// Delete removes a user, or returns ErrNotFound.
+func (r *InMemoryUserRepository) Delete(ctx context.Context, id uint64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.users[id]; !exists {
+		return ErrNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+// FindByEmail returns the user with the given email, or ErrNotFound.
+func (r *InMemoryUserRepository) FindByEmail(ctx context.Context, email string) (*User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, user := range r.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func matchesFilters(user *User, filters map[string]string) bool {
+	for key, value := range filters {
+		if value == "" {
+			continue
+		}
+		switch key {
+		case "name":
+			if !strings.Contains(strings.ToLower(user.Name), strings.ToLower(value)) {
+				return false
+			}
+		case "email":
+			if !strings.Contains(strings.ToLower(user.Email), strings.ToLower(value)) {
+				return false
+			}
+		case "role":
+			if user.Role != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func sortUsers(users []*User, sort []string) {
+	slices.SortStableFunc(users, func(a, b *User) int {
+		for _, field := range sort {
+			desc := strings.HasPrefix(field, "-")
+			field = strings.TrimPrefix(field, "-")
+
+			cmp := compareUsersByField(a, b, field)
+			if cmp != 0 {
+				if desc {
+					return -cmp
+				}
+				return cmp
+			}
+		}
+		return 0
+	})
+}
+
+func compareUsersByField(a, b *User, field string) int {
+	switch field {
+	case "name":
+		return strings.Compare(a.Name, b.Name)
+	case "email":
+		return strings.Compare(a.Email, b.Email)
+	case "role":
+		return strings.Compare(a.Role, b.Role)
+	case "created_at":
+		return a.CreatedAt.Compare(b.CreatedAt)
+	default: // "id"
+		switch {
+		case a.ID < b.ID:
+			return -1
+		case a.ID > b.ID:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package users
+
+import (
+	"context"
+	"testing"
+)
+
+func seedUsers(t *testing.T, repo *InMemoryUserRepository, n int) {
+	t.Helper()
+	ctx := context.Background()
+	for i := 0; i < n; i++ {
+		user := &User{
+			Name:  "user",
+			Email: string(rune('a'+i)) + "@example.com",
+			Role:  "user",
+		}
+		if err := repo.Create(ctx, user); err != nil {
+			t.Fatalf("seed user %d: %v", i, err)
+		}
+	}
+}
+
+func TestListUsersParamsNormalize(t *testing.T) {
+	cases := []struct {
+		name string
+		in   ListUsersParams
+		want ListUsersParams
+	}{
+		{
+			name: "defaults applied when zero",
+			in:   ListUsersParams{},
+			want: ListUsersParams{Page: 1, PageSize: 20, Sort: []string{"id"}},
+		},
+		{
+			name: "negative page clamps to 1",
+			in:   ListUsersParams{Page: -5, PageSize: 10},
+			want: ListUsersParams{Page: 1, PageSize: 10, Sort: []string{"id"}},
+		},
+		{
+			name: "page_size over the cap clamps to 100",
+			in:   ListUsersParams{Page: 1, PageSize: 500},
+			want: ListUsersParams{Page: 1, PageSize: 100, Sort: []string{"id"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := tc.in
+			params.Normalize()
+			if params.Page != tc.want.Page || params.PageSize != tc.want.PageSize {
+				t.Fatalf("got Page=%d PageSize=%d, want Page=%d PageSize=%d",
+					params.Page, params.PageSize, tc.want.Page, tc.want.PageSize)
+			}
+			if len(params.Sort) != len(tc.want.Sort) || params.Sort[0] != tc.want.Sort[0] {
+				t.Fatalf("got Sort=%v, want Sort=%v", params.Sort, tc.want.Sort)
+			}
+		})
+	}
+}
+
+func TestInMemoryUserRepositoryListPagination(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryUserRepository()
+	seedUsers(t, repo, 5)
+
+	page1, metadata, err := repo.List(ctx, ListUsersParams{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List page 1: %v", err)
+	}
+	if len(page1) != 2 {
+		t.Fatalf("page 1: got %d users, want 2", len(page1))
+	}
+	if metadata.TotalRecords != 5 || metadata.LastPage != 3 {
+		t.Fatalf("unexpected metadata: %+v", metadata)
+	}
+
+	lastPage, _, err := repo.List(ctx, ListUsersParams{Page: 3, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List last page: %v", err)
+	}
+	if len(lastPage) != 1 {
+		t.Fatalf("last page: got %d users, want 1 (off-by-one in page math)", len(lastPage))
+	}
+
+	pastEnd, _, err := repo.List(ctx, ListUsersParams{Page: 10, PageSize: 2})
+	if err != nil {
+		t.Fatalf("List past end: %v", err)
+	}
+	if len(pastEnd) != 0 {
+		t.Fatalf("page past the end: got %d users, want 0", len(pastEnd))
+	}
+}
+
+func TestInMemoryUserRepositoryListSortAndFilter(t *testing.T) {
+	ctx := context.Background()
+	repo := NewInMemoryUserRepository()
+
+	if err := repo.Create(ctx, &User{Name: "Carol", Email: "carol@example.com", Role: "admin"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Create(ctx, &User{Name: "Alice", Email: "alice@example.com", Role: "user"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Create(ctx, &User{Name: "Bob", Email: "bob@example.com", Role: "admin"}); err != nil {
+		t.Fatal(err)
+	}
+
+	admins, _, err := repo.List(ctx, ListUsersParams{Filters: map[string]string{"role": "admin"}})
+	if err != nil {
+		t.Fatalf("List filtered by role: %v", err)
+	}
+	if len(admins) != 2 {
+		t.Fatalf("got %d admins, want 2", len(admins))
+	}
+	if admins[0].Name != "Carol" || admins[1].Name != "Bob" {
+		t.Fatalf("expected admins sorted by id ascending (Carol, Bob), got %s, %s", admins[0].Name, admins[1].Name)
+	}
+
+	byName, _, err := repo.List(ctx, ListUsersParams{Sort: []string{"name"}})
+	if err != nil {
+		t.Fatalf("List sorted by name: %v", err)
+	}
+	names := []string{byName[0].Name, byName[1].Name, byName[2].Name}
+	if names[0] != "Alice" || names[1] != "Bob" || names[2] != "Carol" {
+		t.Fatalf("got names %v, want [Alice Bob Carol]", names)
+	}
+
+	byNameDesc, _, err := repo.List(ctx, ListUsersParams{Sort: []string{"-name"}})
+	if err != nil {
+		t.Fatalf("List sorted by -name: %v", err)
+	}
+	if byNameDesc[0].Name != "Carol" {
+		t.Fatalf("got first name %q, want Carol for descending sort", byNameDesc[0].Name)
+	}
+}
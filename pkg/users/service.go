@@ -0,0 +1,84 @@
+package users
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// UserService handles user operations
+type UserService struct {
+	repo    UserRepository
+	timeout time.Duration
+}
+
+// NewUserService creates a new user service backed by repo.
+func NewUserService(repo UserRepository, timeout time.Duration) *UserService {
+	return &UserService{
+		repo:    repo,
+		timeout: timeout,
+	}
+}
+
+// CreateUser adds a new user to the service
+func (s *UserService) CreateUser(ctx context.Context, name, email, role string) (*User, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	if name == "" || email == "" || role == "" {
+		return nil, fmt.Errorf("name, email, and role are required")
+	}
+
+	user := &User{
+		Name:  name,
+		Email: email,
+		Role:  role,
+	}
+
+	if err := s.repo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// GetUser retrieves a user by ID
+func (s *UserService) GetUser(ctx context.Context, id uint64) (*User, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+
+	return s.repo.Get(ctx, id)
+}
+
+// ListUsers returns a page of users matching params.Filters, sorted by
+// params.Sort, along with pagination metadata.
+func (s *UserService) ListUsers(ctx context.Context, params ListUsersParams) ([]*User, Metadata, error) {
+	select {
+	case <-ctx.Done():
+		return nil, Metadata{}, ctx.Err()
+	default:
+	}
+
+	for _, field := range params.Sort {
+		field = strings.TrimPrefix(field, "-")
+		if !allowedSortFields[field] {
+			return nil, Metadata{}, fmt.Errorf("invalid sort field %q", field)
+		}
+	}
+
+	params.Normalize()
+	return s.repo.List(ctx, params)
+}
+
+// Timeout returns the per-request timeout handlers should apply around
+// calls into the service.
+func (s *UserService) Timeout() time.Duration {
+	return s.timeout
+}
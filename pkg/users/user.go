@@ -0,0 +1,91 @@
+// Package users contains the User domain model, the UserRepository
+// persistence boundary, and the UserService/AuthService business logic on
+// top of it.
+package users
+
+import (
+	"errors"
+	"time"
+)
+
+// User represents a user in the system
+type User struct {
+	ID           uint64    `json:"id" db:"id" gorm:"primaryKey"`
+	Name         string    `json:"name" db:"name"`
+	Email        string    `json:"email" db:"email" gorm:"uniqueIndex"`
+	Role         string    `json:"role" db:"role"`
+	PasswordHash string    `json:"-" db:"password_hash"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Repository errors. Handlers map these to HTTP status codes instead of
+// inspecting error strings.
+var (
+	ErrNotFound = errors.New("user not found")
+	ErrConflict = errors.New("user already exists")
+)
+
+// allowedSortFields is the allowlist of columns ListUsers may sort by. It
+// exists so a `sort` query param can never be used to inject arbitrary SQL
+// once the GORM backend is in play.
+var allowedSortFields = map[string]bool{
+	"id":         true,
+	"name":       true,
+	"email":      true,
+	"role":       true,
+	"created_at": true,
+}
+
+// maxPageSize caps page_size so a client can't force an unbounded scan.
+const maxPageSize = 100
+
+// ListUsersParams carries pagination, sorting, and filtering for ListUsers.
+type ListUsersParams struct {
+	Page     int
+	PageSize int
+	Sort     []string          // e.g. []string{"-created_at", "name"}
+	Filters  map[string]string // keys: name, email, role
+}
+
+// Normalize fills in defaults and clamps values to their valid ranges.
+func (p *ListUsersParams) Normalize() {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = 20
+	}
+	if p.PageSize > maxPageSize {
+		p.PageSize = maxPageSize
+	}
+	if len(p.Sort) == 0 {
+		p.Sort = []string{"id"}
+	}
+}
+
+// Metadata describes the page returned by ListUsers, mirroring the
+// pagination envelope used throughout the Go web-API ecosystem.
+type Metadata struct {
+	CurrentPage  int `json:"current_page"`
+	PageSize     int `json:"page_size"`
+	FirstPage    int `json:"first_page"`
+	LastPage     int `json:"last_page"`
+	TotalRecords int `json:"total_records"`
+}
+
+// calculateMetadata builds a Metadata from a total record count and the
+// requesting page/page_size.
+func calculateMetadata(totalRecords, page, pageSize int) Metadata {
+	if totalRecords == 0 {
+		return Metadata{}
+	}
+
+	return Metadata{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		FirstPage:    1,
+		LastPage:     (totalRecords + pageSize - 1) / pageSize,
+		TotalRecords: totalRecords,
+	}
+}